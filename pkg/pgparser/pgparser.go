@@ -132,6 +132,21 @@ func NormalizePostgreSQLQualifiedName(ctx parser.IQualified_nameContext) []strin
 	return res
 }
 
+// NormalizePostgreSQLColumnRef normalizes a column reference (schema.table.column).
+// Returns a slice of name parts (e.g., ["table", "column"]).
+func NormalizePostgreSQLColumnRef(ctx parser.IColumnrefContext) []string {
+	if ctx == nil {
+		return []string{}
+	}
+
+	res := []string{NormalizePostgreSQLColid(ctx.Colid())}
+
+	if ctx.Indirection() != nil {
+		res = append(res, normalizePostgreSQLIndirection(ctx.Indirection())...)
+	}
+	return res
+}
+
 // normalizePostgreSQLIndirection normalizes indirection elements.
 func normalizePostgreSQLIndirection(ctx parser.IIndirectionContext) []string {
 	if ctx == nil {
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*ColumnDisallowDropAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleColumnDisallowDrop),
+		&ColumnDisallowDropAdvisor{},
+	)
+}
+
+// ColumnDisallowDropAdvisor is the advisor checking for disallow DROP COLUMN statement.
+type ColumnDisallowDropAdvisor struct{}
+
+func (*ColumnDisallowDropAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &columnDisallowDropChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type columnDisallowDropChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+}
+
+func (c *columnDisallowDropChecker) EnterAltertablestmt(ctx *parser.AltertablestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil {
+		return
+	}
+	tableName := quoteQualifiedName(ctx.Relation_expr().Qualified_name())
+
+	if ctx.Alter_table_cmds() == nil {
+		return
+	}
+
+	for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+		columnName, ok := droppedColumnName(cmd)
+		if !ok {
+			continue
+		}
+
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status:  c.level,
+			Code:    int32(types.DropColumn),
+			Title:   c.title,
+			Content: fmt.Sprintf("drops column %q of table %s", columnName, tableName),
+			StartPosition: &types.Position{
+				Line: int32(cmd.GetStart().GetLine()),
+			},
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/catalog"
+	"github.com/nsxbet/sql-reviewer/pkg/pgparser"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*ColumnDisallowDropInIndexAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleColumnDisallowDropInIndex),
+		&ColumnDisallowDropInIndexAdvisor{},
+	)
+}
+
+// ColumnDisallowDropInIndexAdvisor is the advisor checking for disallow DROP COLUMN on indexed columns.
+type ColumnDisallowDropInIndexAdvisor struct{}
+
+func (*ColumnDisallowDropInIndexAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &columnDisallowDropInIndexChecker{
+		level:   level,
+		title:   string(checkCtx.Rule.Type),
+		catalog: getCatalogFinder(checkCtx),
+		indexes: make(map[string][]*indexedColumns),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+// indexedColumns is an index created by the statements under review.
+type indexedColumns struct {
+	name    string
+	columns []string
+}
+
+type columnDisallowDropInIndexChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+	catalog    *catalog.Finder
+
+	// indexes maps "schema.table" to the indexes created in the reviewed statements.
+	indexes map[string][]*indexedColumns
+}
+
+func (c *columnDisallowDropInIndexChecker) EnterCreatestmt(ctx *parser.CreatestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	allQualifiedNames := ctx.AllQualified_name()
+	if len(allQualifiedNames) == 0 {
+		return
+	}
+	schemaName, tableName := c.normalizeTable(allQualifiedNames[0])
+
+	if ctx.Opttableelementlist() == nil || ctx.Opttableelementlist().Tableelementlist() == nil {
+		return
+	}
+
+	for _, elem := range ctx.Opttableelementlist().Tableelementlist().AllTableelement() {
+		if colDef := elem.ColumnDef(); colDef != nil && colDef.Colid() != nil && colDef.Colquallist() != nil {
+			columnName := pgparser.NormalizePostgreSQLColid(colDef.Colid())
+			for _, constraint := range colDef.Colquallist().AllColconstraint() {
+				constraintElem := constraint.Colconstraintelem()
+				if constraintElem == nil {
+					continue
+				}
+				var indexName string
+				switch {
+				case constraintElem.PRIMARY() != nil:
+					indexName = fmt.Sprintf("%s_pkey", tableName)
+				case constraintElem.UNIQUE() != nil:
+					indexName = fmt.Sprintf("%s_%s_key", tableName, columnName)
+				default:
+					continue
+				}
+				if constraint.Name() != nil {
+					indexName = pgparser.NormalizePostgreSQLName(constraint.Name())
+				}
+				c.addIndex(schemaName, tableName, indexName, []string{columnName})
+			}
+		}
+
+		if elem.Tableconstraint() != nil {
+			c.addTableConstraint(schemaName, tableName, elem.Tableconstraint())
+		}
+	}
+}
+
+func (c *columnDisallowDropInIndexChecker) EnterIndexstmt(ctx *parser.IndexstmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil || ctx.Index_params() == nil {
+		return
+	}
+	schemaName, tableName := c.normalizeTable(ctx.Relation_expr().Qualified_name())
+
+	var columns []string
+	for _, param := range ctx.Index_params().AllIndex_elem() {
+		if param.Colid() != nil {
+			columns = append(columns, pgparser.NormalizePostgreSQLColid(param.Colid()))
+		}
+	}
+
+	indexName := fmt.Sprintf("%s_%s_idx", tableName, strings.Join(columns, "_"))
+	if ctx.Name() != nil {
+		indexName = pgparser.NormalizePostgreSQLName(ctx.Name())
+	}
+	c.addIndex(schemaName, tableName, indexName, columns)
+}
+
+func (c *columnDisallowDropInIndexChecker) EnterAltertablestmt(ctx *parser.AltertablestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil || ctx.Alter_table_cmds() == nil {
+		return
+	}
+	qualifiedName := ctx.Relation_expr().Qualified_name()
+	schemaName, tableName := c.normalizeTable(qualifiedName)
+
+	for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+		if cmd.ADD_P() != nil && cmd.Tableconstraint() != nil {
+			c.addTableConstraint(schemaName, tableName, cmd.Tableconstraint())
+			continue
+		}
+
+		columnName, ok := droppedColumnName(cmd)
+		if !ok {
+			continue
+		}
+
+		if indexName := c.findIndexContainingColumn(schemaName, tableName, columnName); indexName != "" {
+			c.adviceList = append(c.adviceList, &types.Advice{
+				Status: c.level,
+				Code:   int32(types.DropIndexColumn),
+				Title:  c.title,
+				Content: fmt.Sprintf(
+					"Column %q of table %s cannot be dropped because it is used by index %q",
+					columnName,
+					quoteQualifiedName(qualifiedName),
+					indexName,
+				),
+				StartPosition: &types.Position{
+					Line: int32(cmd.GetStart().GetLine()),
+				},
+			})
+		}
+	}
+}
+
+func (c *columnDisallowDropInIndexChecker) addTableConstraint(schemaName, tableName string, constraint parser.ITableconstraintContext) {
+	elem := constraint.Constraintelem()
+	if elem == nil || elem.Columnlist() == nil {
+		return
+	}
+	if elem.PRIMARY() == nil && elem.UNIQUE() == nil {
+		return
+	}
+
+	var columns []string
+	for _, col := range elem.Columnlist().AllColumnElem() {
+		if col.Colid() != nil {
+			columns = append(columns, pgparser.NormalizePostgreSQLColid(col.Colid()))
+		}
+	}
+
+	indexName := fmt.Sprintf("%s_%s_key", tableName, strings.Join(columns, "_"))
+	if elem.PRIMARY() != nil {
+		indexName = fmt.Sprintf("%s_pkey", tableName)
+	}
+	if constraint.Name() != nil {
+		indexName = pgparser.NormalizePostgreSQLName(constraint.Name())
+	}
+	c.addIndex(schemaName, tableName, indexName, columns)
+}
+
+func (c *columnDisallowDropInIndexChecker) addIndex(schemaName, tableName, indexName string, columns []string) {
+	key := fmt.Sprintf("%s.%s", schemaName, tableName)
+	c.indexes[key] = append(c.indexes[key], &indexedColumns{name: indexName, columns: columns})
+}
+
+// findIndexContainingColumn returns the name of an index covering the column,
+// looking at indexes created in the reviewed statements first and then at the
+// indexes that already exist in the database.
+func (c *columnDisallowDropInIndexChecker) findIndexContainingColumn(schemaName, tableName, columnName string) string {
+	for _, index := range c.indexes[fmt.Sprintf("%s.%s", schemaName, tableName)] {
+		if slices.Contains(index.columns, columnName) {
+			return index.name
+		}
+	}
+
+	if c.catalog == nil || c.catalog.Origin == nil {
+		return ""
+	}
+
+	indexMap := c.catalog.Origin.Index(&catalog.TableIndexFind{
+		SchemaName: schemaName,
+		TableName:  tableName,
+	})
+	if indexMap == nil {
+		return ""
+	}
+
+	// Sort index names for deterministic output.
+	var indexNames []string
+	for name := range *indexMap {
+		indexNames = append(indexNames, name)
+	}
+	slices.Sort(indexNames)
+
+	for _, name := range indexNames {
+		if slices.Contains((*indexMap)[name].ExpressionList(), columnName) {
+			return name
+		}
+	}
+	return ""
+}
+
+func (*columnDisallowDropInIndexChecker) normalizeTable(qualifiedName parser.IQualified_nameContext) (string, string) {
+	schemaName := extractSchemaName(qualifiedName)
+	if schemaName == "" {
+		schemaName = "public"
+	}
+	return schemaName, extractTableName(qualifiedName)
+}
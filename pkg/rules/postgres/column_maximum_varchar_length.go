@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/pgparser"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*ColumnMaximumVarcharLengthAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleColumnMaximumVarcharLength),
+		&ColumnMaximumVarcharLengthAdvisor{},
+	)
+}
+
+// ColumnMaximumVarcharLengthAdvisor is the advisor checking for maximum varchar length.
+type ColumnMaximumVarcharLengthAdvisor struct{}
+
+func (*ColumnMaximumVarcharLengthAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Number <= 0 {
+		return nil, nil
+	}
+
+	checker := &columnMaximumVarcharLengthChecker{
+		level:   level,
+		title:   string(checkCtx.Rule.Type),
+		maximum: payload.Number,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type columnMaximumVarcharLengthChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+	maximum    int
+}
+
+func (c *columnMaximumVarcharLengthChecker) EnterCreatestmt(ctx *parser.CreatestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	allQualifiedNames := ctx.AllQualified_name()
+	if len(allQualifiedNames) == 0 {
+		return
+	}
+	tableName := quoteQualifiedName(allQualifiedNames[0])
+
+	if ctx.Opttableelementlist() == nil || ctx.Opttableelementlist().Tableelementlist() == nil {
+		return
+	}
+
+	for _, elem := range ctx.Opttableelementlist().Tableelementlist().AllTableelement() {
+		colDef := elem.ColumnDef()
+		if colDef == nil || colDef.Colid() == nil || colDef.Typename() == nil {
+			continue
+		}
+		columnName := pgparser.NormalizePostgreSQLColid(colDef.Colid())
+		if c.getVarcharLength(colDef.Typename()) > c.maximum {
+			c.addAdvice(tableName, columnName, colDef.GetStart().GetLine())
+		}
+	}
+}
+
+func (c *columnMaximumVarcharLengthChecker) EnterAltertablestmt(ctx *parser.AltertablestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil || ctx.Alter_table_cmds() == nil {
+		return
+	}
+	tableName := quoteQualifiedName(ctx.Relation_expr().Qualified_name())
+
+	for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+		// ADD COLUMN
+		if cmd.ADD_P() != nil && cmd.ColumnDef() != nil {
+			colDef := cmd.ColumnDef()
+			if colDef.Colid() != nil && colDef.Typename() != nil {
+				columnName := pgparser.NormalizePostgreSQLColid(colDef.Colid())
+				if c.getVarcharLength(colDef.Typename()) > c.maximum {
+					c.addAdvice(tableName, columnName, colDef.GetStart().GetLine())
+				}
+			}
+			continue
+		}
+
+		// ALTER COLUMN ... TYPE
+		if cmd.ALTER() != nil && cmd.TYPE_P() != nil && cmd.Typename() != nil && len(cmd.AllColid()) > 0 {
+			columnName := pgparser.NormalizePostgreSQLColid(cmd.Colid(0))
+			if c.getVarcharLength(cmd.Typename()) > c.maximum {
+				c.addAdvice(tableName, columnName, cmd.GetStart().GetLine())
+			}
+		}
+	}
+}
+
+// getVarcharLength returns the declared length of varchar(n) and character varying(n) types,
+// or 0 if the type is not a length-limited varchar.
+func (*columnMaximumVarcharLengthChecker) getVarcharLength(typename parser.ITypenameContext) int {
+	if typename == nil || typename.Simpletypename() == nil {
+		return 0
+	}
+
+	character := typename.Simpletypename().Character()
+	if character == nil || character.Character_c() == nil || character.Iconst() == nil {
+		return 0
+	}
+
+	characterC := character.Character_c()
+	isVarchar := characterC.VARCHAR() != nil ||
+		((characterC.CHARACTER() != nil || characterC.CHAR_P() != nil || characterC.NCHAR() != nil) &&
+			characterC.Opt_varying() != nil)
+	if !isVarchar {
+		return 0
+	}
+
+	size, err := extractIntegerConstant(character.Iconst())
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
+func (c *columnMaximumVarcharLengthChecker) addAdvice(tableName, columnName string, line int) {
+	c.adviceList = append(c.adviceList, &types.Advice{
+		Status: c.level,
+		Code:   int32(types.VarcharLengthExceedsLimit),
+		Title:  c.title,
+		Content: fmt.Sprintf(
+			"The length of the VARCHAR column %q in table %s is bigger than %d",
+			columnName,
+			tableName,
+			c.maximum,
+		),
+		StartPosition: &types.Position{
+			Line: int32(line),
+		},
+	})
+}
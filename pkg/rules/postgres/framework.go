@@ -159,6 +159,25 @@ func NormalizeSchemaName(schemaName string) string {
 	return schemaName
 }
 
+// tableColumnTypes tracks table column types across statements
+// "schema.table" --> columnName --> columnType
+type tableColumnTypes map[string]map[string]string
+
+func (t tableColumnTypes) set(tableKey string, columnName string, columnType string) {
+	if _, ok := t[tableKey]; !ok {
+		t[tableKey] = make(map[string]string)
+	}
+	t[tableKey][columnName] = columnType
+}
+
+func (t tableColumnTypes) get(tableKey string, columnName string) (columnType string, ok bool) {
+	if _, ok := t[tableKey]; !ok {
+		return "", false
+	}
+	col, ok := t[tableKey][columnName]
+	return col, ok
+}
+
 // ConvertSyntaxErrorToAdvice converts PostgreSQL parser syntax errors to
 // advice format, matching the pattern used in MySQL rules and Bytebase.
 //
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/catalog"
+	"github.com/nsxbet/sql-reviewer/pkg/pgparser"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*IndexTypeNoBlobAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleIndexTypeNoBlob),
+		&IndexTypeNoBlobAdvisor{},
+	)
+}
+
+// IndexTypeNoBlobAdvisor is the advisor checking for BYTEA columns in indexes.
+type IndexTypeNoBlobAdvisor struct{}
+
+func (*IndexTypeNoBlobAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &indexTypeNoBlobChecker{
+		level:            level,
+		title:            string(checkCtx.Rule.Type),
+		catalog:          getCatalogFinder(checkCtx),
+		tablesNewColumns: make(tableColumnTypes),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type indexTypeNoBlobChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList       []*types.Advice
+	level            types.Advice_Status
+	title            string
+	catalog          *catalog.Finder
+	tablesNewColumns tableColumnTypes
+}
+
+func (c *indexTypeNoBlobChecker) EnterCreatestmt(ctx *parser.CreatestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	allQualifiedNames := ctx.AllQualified_name()
+	if len(allQualifiedNames) == 0 {
+		return
+	}
+	qualifiedName := allQualifiedNames[0]
+
+	if ctx.Opttableelementlist() == nil || ctx.Opttableelementlist().Tableelementlist() == nil {
+		return
+	}
+
+	allElements := ctx.Opttableelementlist().Tableelementlist().AllTableelement()
+	for _, elem := range allElements {
+		if elem.ColumnDef() != nil {
+			c.checkColumnDef(qualifiedName, elem.ColumnDef())
+		}
+	}
+	for _, elem := range allElements {
+		if elem.Tableconstraint() != nil {
+			c.checkTableConstraint(qualifiedName, elem.Tableconstraint())
+		}
+	}
+}
+
+func (c *indexTypeNoBlobChecker) EnterAltertablestmt(ctx *parser.AltertablestmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil || ctx.Alter_table_cmds() == nil {
+		return
+	}
+	qualifiedName := ctx.Relation_expr().Qualified_name()
+
+	for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+		switch {
+		// ADD COLUMN
+		case cmd.ADD_P() != nil && cmd.ColumnDef() != nil:
+			c.checkColumnDef(qualifiedName, cmd.ColumnDef())
+		// ADD CONSTRAINT
+		case cmd.ADD_P() != nil && cmd.Tableconstraint() != nil:
+			c.checkTableConstraint(qualifiedName, cmd.Tableconstraint())
+		// ALTER COLUMN ... TYPE
+		case cmd.ALTER() != nil && cmd.TYPE_P() != nil && cmd.Typename() != nil && len(cmd.AllColid()) > 0:
+			columnName := pgparser.NormalizePostgreSQLColid(cmd.Colid(0))
+			c.tablesNewColumns.set(c.tableKey(qualifiedName), columnName, getTypeText(cmd.Typename()))
+		}
+	}
+}
+
+func (c *indexTypeNoBlobChecker) EnterIndexstmt(ctx *parser.IndexstmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.Relation_expr() == nil || ctx.Relation_expr().Qualified_name() == nil || ctx.Index_params() == nil {
+		return
+	}
+	qualifiedName := ctx.Relation_expr().Qualified_name()
+
+	for _, param := range ctx.Index_params().AllIndex_elem() {
+		if param.Colid() == nil {
+			continue
+		}
+		columnName := pgparser.NormalizePostgreSQLColid(param.Colid())
+		c.checkColumn(qualifiedName, columnName, c.getColumnType(qualifiedName, columnName), ctx.GetStart().GetLine())
+	}
+}
+
+func (c *indexTypeNoBlobChecker) checkColumnDef(qualifiedName parser.IQualified_nameContext, colDef parser.IColumnDefContext) {
+	if colDef.Colid() == nil || colDef.Typename() == nil {
+		return
+	}
+
+	columnName := pgparser.NormalizePostgreSQLColid(colDef.Colid())
+	columnType := getTypeText(colDef.Typename())
+	c.tablesNewColumns.set(c.tableKey(qualifiedName), columnName, columnType)
+
+	if colDef.Colquallist() == nil {
+		return
+	}
+	for _, constraint := range colDef.Colquallist().AllColconstraint() {
+		elem := constraint.Colconstraintelem()
+		if elem == nil {
+			continue
+		}
+		if elem.PRIMARY() != nil || elem.UNIQUE() != nil {
+			c.checkColumn(qualifiedName, columnName, columnType, colDef.GetStart().GetLine())
+		}
+	}
+}
+
+func (c *indexTypeNoBlobChecker) checkTableConstraint(qualifiedName parser.IQualified_nameContext, constraint parser.ITableconstraintContext) {
+	elem := constraint.Constraintelem()
+	if elem == nil || elem.Columnlist() == nil {
+		return
+	}
+	if elem.PRIMARY() == nil && elem.UNIQUE() == nil {
+		return
+	}
+
+	for _, col := range elem.Columnlist().AllColumnElem() {
+		if col.Colid() == nil {
+			continue
+		}
+		columnName := pgparser.NormalizePostgreSQLColid(col.Colid())
+		c.checkColumn(qualifiedName, columnName, c.getColumnType(qualifiedName, columnName), constraint.GetStart().GetLine())
+	}
+}
+
+func (c *indexTypeNoBlobChecker) checkColumn(qualifiedName parser.IQualified_nameContext, columnName, columnType string, line int) {
+	if normalizePostgreSQLType(columnType) != "bytea" {
+		return
+	}
+
+	c.adviceList = append(c.adviceList, &types.Advice{
+		Status: c.level,
+		Code:   int32(types.IndexTypeNoBlob),
+		Title:  c.title,
+		Content: fmt.Sprintf(
+			"Columns in index must not be BYTEA but %s.%q is %s",
+			quoteQualifiedName(qualifiedName),
+			columnName,
+			normalizePostgreSQLType(columnType),
+		),
+		StartPosition: &types.Position{
+			Line: int32(line),
+		},
+	})
+}
+
+// getColumnType gets the column type from the reviewed statements or the catalog,
+// returns empty string if the column cannot be found.
+func (c *indexTypeNoBlobChecker) getColumnType(qualifiedName parser.IQualified_nameContext, columnName string) string {
+	if columnType, ok := c.tablesNewColumns.get(c.tableKey(qualifiedName), columnName); ok {
+		return columnType
+	}
+
+	if c.catalog != nil && c.catalog.Origin != nil {
+		column := c.catalog.Origin.FindColumn(&catalog.ColumnFind{
+			SchemaName: NormalizeSchemaName(extractSchemaName(qualifiedName)),
+			TableName:  extractTableName(qualifiedName),
+			ColumnName: columnName,
+		})
+		if column != nil {
+			return column.Type()
+		}
+	}
+
+	return ""
+}
+
+func (*indexTypeNoBlobChecker) tableKey(qualifiedName parser.IQualified_nameContext) string {
+	return fmt.Sprintf("%s.%s", NormalizeSchemaName(extractSchemaName(qualifiedName)), extractTableName(qualifiedName))
+}
@@ -43,7 +43,10 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleColumnCommentConvention,
 		advisor.SchemaRuleColumnDefaultDisallowVolatile,
 		advisor.SchemaRuleColumnDisallowChangeType,
+		advisor.SchemaRuleColumnDisallowDrop,
+		advisor.SchemaRuleColumnDisallowDropInIndex,
 		advisor.SchemaRuleColumnMaximumCharacterLength,
+		advisor.SchemaRuleColumnMaximumVarcharLength,
 		advisor.SchemaRuleColumnNaming,
 		advisor.SchemaRuleColumnNotNull,
 		advisor.SchemaRuleColumnRequireDefault,
@@ -55,8 +58,10 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleIDXNaming,
 		advisor.SchemaRuleIndexKeyNumberLimit,
 		advisor.SchemaRuleIndexNoDuplicateColumn,
+		advisor.SchemaRuleIndexPKTypeLimit,
 		advisor.SchemaRuleIndexPrimaryKeyTypeAllowlist,
 		advisor.SchemaRuleIndexTotalNumberLimit,
+		advisor.SchemaRuleIndexTypeNoBlob,
 		advisor.SchemaRulePKNaming,
 		advisor.SchemaRuleRequiredColumn,
 		advisor.SchemaRuleSchemaBackwardCompatibility,
@@ -76,6 +81,8 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleStatementInsertDisallowOrderByRand,
 		advisor.SchemaRuleStatementInsertMustSpecifyColumn,
 		advisor.SchemaRuleStatementInsertRowLimit,
+		advisor.SchemaRuleStatementJoinStrictColumnAttrs,
+		advisor.SchemaRuleStatementMaximumJoinTableCount,
 		advisor.SchemaRuleStatementMaximumLimitValue,
 		advisor.SchemaRuleStatementMergeAlterTable,
 		advisor.SchemaRuleStatementNoLeadingWildcardLike,
@@ -85,6 +92,10 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleStatementPriorBackupCheck,
 		advisor.SchemaRuleStatementRequireWhereForSelect,
 		advisor.SchemaRuleStatementRequireWhereForUpdateDelete,
+		advisor.SchemaRuleStatementSelectFullTableScan,
+		advisor.SchemaRuleStatementWhereDisallowFunctionsAndCaculations,
+		advisor.SchemaRuleStatementWhereMaximumLogicalOperatorCount,
+		advisor.SchemaRuleStatementWhereNoEqualNull,
 		advisor.SchemaRuleTableCommentConvention,
 		advisor.SchemaRuleTableDisallowPartition,
 		advisor.SchemaRuleTableDropNamingConvention,
@@ -104,14 +115,18 @@ func TestPostgreSQLRules(t *testing.T) {
 // These rules interact with existing database schema (e.g., checking existing indexes,
 // primary keys, or object ownership).
 var rulesNeedingMetadata = map[advisor.SQLReviewRuleType]bool{
-	advisor.SchemaRuleColumnNotNull:             true, // PRIMARY KEY USING INDEX
-	advisor.SchemaRuleTableRequirePK:            true, // DROP CONSTRAINT/COLUMN checks
-	advisor.SchemaRuleIndexTotalNumberLimit:     true, // Count existing indexes
-	advisor.SchemaRulePKNaming:                  true, // PRIMARY KEY USING INDEX
-	advisor.SchemaRuleUKNaming:                  true, // UNIQUE USING INDEX
-	advisor.SchemaRuleIDXNaming:                 true, // ALTER INDEX RENAME
-	advisor.SchemaRuleFullyQualifiedObjectName:  true, // SELECT statement checks
-	advisor.SchemaRuleStatementObjectOwnerCheck: true, // Ownership checks
+	advisor.SchemaRuleColumnNotNull:                  true, // PRIMARY KEY USING INDEX
+	advisor.SchemaRuleTableRequirePK:                 true, // DROP CONSTRAINT/COLUMN checks
+	advisor.SchemaRuleIndexTotalNumberLimit:          true, // Count existing indexes
+	advisor.SchemaRulePKNaming:                       true, // PRIMARY KEY USING INDEX
+	advisor.SchemaRuleUKNaming:                       true, // UNIQUE USING INDEX
+	advisor.SchemaRuleIDXNaming:                      true, // ALTER INDEX RENAME
+	advisor.SchemaRuleFullyQualifiedObjectName:       true, // SELECT statement checks
+	advisor.SchemaRuleStatementObjectOwnerCheck:      true, // Ownership checks
+	advisor.SchemaRuleColumnDisallowDropInIndex:      true, // Existing indexes covering the column
+	advisor.SchemaRuleIndexPKTypeLimit:               true, // Existing column types
+	advisor.SchemaRuleIndexTypeNoBlob:                true, // Existing column types
+	advisor.SchemaRuleStatementJoinStrictColumnAttrs: true, // Existing column types
 }
 
 // runPostgreSQLRuleTest runs a single PostgreSQL rule against its testdata file.
@@ -336,6 +351,10 @@ func getDefaultPayload(rule advisor.SQLReviewRuleType) (map[string]interface{},
 		return map[string]interface{}{
 			"number": 20,
 		}, nil
+	case advisor.SchemaRuleColumnMaximumVarcharLength:
+		return map[string]interface{}{
+			"number": 2560,
+		}, nil
 	case advisor.SchemaRuleColumnCommentConvention:
 		return map[string]interface{}{
 			"required":  true,
@@ -362,6 +381,14 @@ func getDefaultPayload(rule advisor.SQLReviewRuleType) (map[string]interface{},
 		return map[string]interface{}{
 			"number": 1000,
 		}, nil
+	case advisor.SchemaRuleStatementMaximumJoinTableCount:
+		return map[string]interface{}{
+			"number": 2,
+		}, nil
+	case advisor.SchemaRuleStatementWhereMaximumLogicalOperatorCount:
+		return map[string]interface{}{
+			"number": 10,
+		}, nil
 	case advisor.SchemaRuleStatementAffectedRowLimit:
 		return map[string]interface{}{
 			"number": 1000,
@@ -591,6 +618,22 @@ func TestPostgreSQLRulesNilCatalogNoPanic(t *testing.T) {
 				"templateList": []string{"table", "column_list"},
 			},
 		},
+		{
+			rule: advisor.SchemaRuleColumnDisallowDropInIndex,
+			sql:  "ALTER TABLE users DROP COLUMN email;",
+		},
+		{
+			rule: advisor.SchemaRuleIndexPKTypeLimit,
+			sql:  "ALTER TABLE users ADD PRIMARY KEY (email);",
+		},
+		{
+			rule: advisor.SchemaRuleIndexTypeNoBlob,
+			sql:  "CREATE INDEX idx_users_email ON users(email);",
+		},
+		{
+			rule: advisor.SchemaRuleStatementJoinStrictColumnAttrs,
+			sql:  "SELECT * FROM users u JOIN orders o ON u.id = o.user_id;",
+		},
 		{
 			rule:    advisor.SchemaRuleFullyQualifiedObjectName,
 			sql:     "SELECT * FROM users;",
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/catalog"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*StatementJoinStrictColumnAttrsAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementJoinStrictColumnAttrs),
+		&StatementJoinStrictColumnAttrsAdvisor{},
+	)
+}
+
+// StatementJoinStrictColumnAttrsAdvisor is the advisor checking that columns compared in
+// JOIN ... ON conditions have the same type.
+type StatementJoinStrictColumnAttrsAdvisor struct{}
+
+func (*StatementJoinStrictColumnAttrsAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	finder := getCatalogFinder(checkCtx)
+	if finder == nil || finder.Final == nil {
+		return nil, nil
+	}
+
+	checker := &statementJoinStrictColumnAttrsChecker{
+		level:   level,
+		title:   string(checkCtx.Rule.Type),
+		catalog: finder,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type statementJoinStrictColumnAttrsChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+	catalog    *catalog.Finder
+
+	// tableRefsStack holds the FROM clause tables of the enclosing SELECT statements.
+	tableRefsStack [][]*tableReference
+	joinQualDepth  int
+}
+
+func (c *statementJoinStrictColumnAttrsChecker) EnterSimple_select_pramary(ctx *parser.Simple_select_pramaryContext) {
+	c.tableRefsStack = append(c.tableRefsStack, extractTableReferences(ctx.From_clause()))
+}
+
+func (c *statementJoinStrictColumnAttrsChecker) ExitSimple_select_pramary(*parser.Simple_select_pramaryContext) {
+	c.tableRefsStack = c.tableRefsStack[:len(c.tableRefsStack)-1]
+}
+
+func (c *statementJoinStrictColumnAttrsChecker) EnterJoin_qual(*parser.Join_qualContext) {
+	c.joinQualDepth++
+}
+
+func (c *statementJoinStrictColumnAttrsChecker) ExitJoin_qual(*parser.Join_qualContext) {
+	c.joinQualDepth--
+}
+
+func (c *statementJoinStrictColumnAttrsChecker) EnterA_expr_compare(ctx *parser.A_expr_compareContext) {
+	if c.joinQualDepth == 0 || len(c.tableRefsStack) == 0 || ctx.EQUAL() == nil {
+		return
+	}
+
+	operands := ctx.AllA_expr_like()
+	if len(operands) != 2 {
+		return
+	}
+	leftRef, rightRef := asColumnRef(operands[0]), asColumnRef(operands[1])
+	if leftRef == nil || rightRef == nil {
+		return
+	}
+
+	refs := c.tableRefsStack[len(c.tableRefsStack)-1]
+	leftTable, leftColumn, leftType := c.findColumn(refs, leftRef)
+	rightTable, rightColumn, rightType := c.findColumn(refs, rightRef)
+	if leftType == "" || rightType == "" || areTypesEquivalent(leftType, rightType) {
+		return
+	}
+
+	c.adviceList = append(c.adviceList, &types.Advice{
+		Status: c.level,
+		Code:   int32(types.StatementJoinColumnAttrsNotMatch),
+		Title:  c.title,
+		Content: fmt.Sprintf(
+			"Join columns %q.%q and %q.%q have mismatched types: %s vs %s",
+			leftTable,
+			leftColumn,
+			rightTable,
+			rightColumn,
+			normalizePostgreSQLType(leftType),
+			normalizePostgreSQLType(rightType),
+		),
+		StartPosition: &types.Position{
+			Line: int32(ctx.GetStart().GetLine()),
+		},
+	})
+}
+
+// findColumn resolves a column reference against the FROM clause tables and returns
+// the table name, column name and column type. The type is empty if the column is unknown.
+func (c *statementJoinStrictColumnAttrsChecker) findColumn(
+	refs []*tableReference,
+	columnRef parser.IColumnrefContext,
+) (string, string, string) {
+	qualifier, columnName := splitColumnRef(columnRef)
+	ref := findTableReference(refs, qualifier)
+	if ref == nil {
+		return "", columnName, ""
+	}
+
+	column := c.catalog.Final.FindColumn(&catalog.ColumnFind{
+		SchemaName: ref.schema,
+		TableName:  ref.table,
+		ColumnName: columnName,
+	})
+	if column == nil {
+		return ref.table, columnName, ""
+	}
+	return ref.table, columnName, column.Type()
+}
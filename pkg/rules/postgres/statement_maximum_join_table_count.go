@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*StatementMaximumJoinTableCountAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementMaximumJoinTableCount),
+		&StatementMaximumJoinTableCountAdvisor{},
+	)
+}
+
+// StatementMaximumJoinTableCountAdvisor is the advisor checking the number of joined tables in a statement.
+type StatementMaximumJoinTableCountAdvisor struct{}
+
+func (*StatementMaximumJoinTableCountAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Number <= 0 {
+		return nil, nil
+	}
+
+	checker := &statementMaximumJoinTableCountChecker{
+		level:         level,
+		title:         string(checkCtx.Rule.Type),
+		limitMaxValue: payload.Number,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type statementMaximumJoinTableCountChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList    []*types.Advice
+	level         types.Advice_Status
+	title         string
+	limitMaxValue int
+
+	text  string
+	count int
+}
+
+func (c *statementMaximumJoinTableCountChecker) EnterStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	c.text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	c.count = 0
+}
+
+func (c *statementMaximumJoinTableCountChecker) EnterJoined_table(ctx *parser.Joined_tableContext) {
+	c.count++
+	// The count starts from 0. We count the number of tables in the joins.
+	if c.count == c.limitMaxValue {
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status:  c.level,
+			Code:    int32(types.StatementMaximumJoinTableCount),
+			Title:   c.title,
+			Content: fmt.Sprintf("\"%s\" exceeds the maximum number of joins %d.", c.text, c.limitMaxValue),
+			StartPosition: &types.Position{
+				Line: int32(ctx.GetStart().GetLine()),
+			},
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var _ advisor.Advisor = (*StatementSelectFullTableScanAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementSelectFullTableScan),
+		&StatementSelectFullTableScanAdvisor{},
+	)
+}
+
+// StatementSelectFullTableScanAdvisor is the advisor checking for sequential scans
+// in the query plan of SELECT statements.
+type StatementSelectFullTableScanAdvisor struct{}
+
+func (*StatementSelectFullTableScanAdvisor) Check(ctx context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &statementSelectFullTableScanChecker{
+		BasePostgreSQLParserListener: &parser.BasePostgreSQLParserListener{},
+		level:                        level,
+		title:                        string(checkCtx.Rule.Type),
+		ctx:                          ctx,
+		driver:                       checkCtx.Driver,
+		usePostgresDatabaseOwner:     checkCtx.UsePostgresDatabaseOwner,
+		statementsText:               checkCtx.Statements,
+	}
+
+	if checker.driver != nil {
+		antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+	}
+
+	return checker.adviceList, nil
+}
+
+type statementSelectFullTableScanChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList               []*types.Advice
+	level                    types.Advice_Status
+	title                    string
+	driver                   *sql.DB
+	ctx                      context.Context
+	explainCount             int
+	setRoles                 []string
+	usePostgresDatabaseOwner bool
+	statementsText           string
+}
+
+func (c *statementSelectFullTableScanChecker) EnterVariablesetstmt(ctx *parser.VariablesetstmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	if ctx.SET() != nil && ctx.Set_rest() != nil && ctx.Set_rest().Set_rest_more() != nil {
+		setRestMore := ctx.Set_rest().Set_rest_more()
+		if setRestMore.ROLE() != nil {
+			stmtText := extractStatementText(c.statementsText, ctx.GetStart().GetLine(), ctx.GetStop().GetLine())
+			c.setRoles = append(c.setRoles, stmtText)
+		}
+	}
+}
+
+func (c *statementSelectFullTableScanChecker) EnterSelectstmt(ctx *parser.SelectstmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	// MaximumLintExplainSize
+	if c.explainCount >= 100 {
+		return
+	}
+	c.explainCount++
+
+	stmtText := extractStatementText(c.statementsText, ctx.GetStart().GetLine(), ctx.GetStop().GetLine())
+	normalizedStmt := advisor.NormalizeStatement(stmtText)
+
+	res, err := advisor.Query(c.ctx, advisor.QueryContext{
+		UsePostgresDatabaseOwner: c.usePostgresDatabaseOwner,
+		PreExecutions:            c.setRoles,
+	}, c.driver, types.Engine_POSTGRES, fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", stmtText))
+	if err != nil {
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status:  c.level,
+			Code:    int32(types.StatementCheckSelectFullTableScanFailed),
+			Title:   c.title,
+			Content: fmt.Sprintf("Failed to check full table scan: %s, with error: %s", normalizedStmt, err),
+			StartPosition: &types.Position{
+				Line: int32(ctx.GetStart().GetLine()),
+			},
+		})
+		return
+	}
+
+	tables, err := getSeqScanTables(res)
+	if err != nil {
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status:  c.level,
+			Code:    int32(types.Internal),
+			Title:   c.title,
+			Content: fmt.Sprintf("Failed to check full table scan: %s, with error: %s", normalizedStmt, err),
+			StartPosition: &types.Position{
+				Line: int32(ctx.GetStart().GetLine()),
+			},
+		})
+		return
+	}
+
+	if len(tables) > 0 {
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status:  c.level,
+			Code:    int32(types.StatementHasTableFullScan),
+			Title:   c.title,
+			Content: fmt.Sprintf("Full table scan detected on table(s): %s", strings.Join(tables, ", ")),
+			StartPosition: &types.Position{
+				Line: int32(ctx.GetStart().GetLine()),
+			},
+		})
+	}
+}
+
+// explainPlanNode is a node of the PostgreSQL EXPLAIN (FORMAT JSON) output.
+type explainPlanNode struct {
+	NodeType     string             `json:"Node Type"`
+	RelationName string             `json:"Relation Name"`
+	Plans        []*explainPlanNode `json:"Plans"`
+}
+
+// getSeqScanTables returns the tables read by a "Seq Scan" node in the EXPLAIN (FORMAT JSON) result.
+func getSeqScanTables(res []any) ([]string, error) {
+	// the res struct is []any{columnName, columnTable, rowDataList}
+	if len(res) != 3 {
+		return nil, errors.Errorf("expected 3 but got %d", len(res))
+	}
+	rowList, ok := res[2].([]any)
+	if !ok {
+		return nil, errors.Errorf("expected []any but got %T", res[2])
+	}
+	// EXPLAIN (FORMAT JSON) returns a single row with a single column.
+	if len(rowList) != 1 {
+		return nil, errors.Errorf("expected one row but got %d", len(rowList))
+	}
+	row, ok := rowList[0].([]any)
+	if !ok {
+		return nil, errors.Errorf("expected []any but got %T", rowList[0])
+	}
+	if len(row) != 1 {
+		return nil, errors.Errorf("expected one column but got %d", len(row))
+	}
+	text, ok := row[0].(string)
+	if !ok {
+		return nil, errors.Errorf("expected string but got %T", row[0])
+	}
+
+	var plans []struct {
+		Plan *explainPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(text), &plans); err != nil {
+		return nil, errors.Wrap(err, "failed to parse EXPLAIN output")
+	}
+
+	var tables []string
+	seen := make(map[string]bool)
+	var walk func(node *explainPlanNode)
+	walk = func(node *explainPlanNode) {
+		if node == nil {
+			return
+		}
+		if node.NodeType == "Seq Scan" && node.RelationName != "" && !seen[node.RelationName] {
+			seen[node.RelationName] = true
+			tables = append(tables, node.RelationName)
+		}
+		for _, child := range node.Plans {
+			walk(child)
+		}
+	}
+	for _, plan := range plans {
+		walk(plan.Plan)
+	}
+	return tables, nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*StatementWhereDisallowFunctionsAndCalculationsAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementWhereDisallowFunctionsAndCaculations),
+		&StatementWhereDisallowFunctionsAndCalculationsAdvisor{},
+	)
+}
+
+// StatementWhereDisallowFunctionsAndCalculationsAdvisor is the advisor checking for
+// function calls and arithmetic in WHERE clauses, which prevent index usage.
+type StatementWhereDisallowFunctionsAndCalculationsAdvisor struct{}
+
+func (*StatementWhereDisallowFunctionsAndCalculationsAdvisor) Check(
+	_ context.Context,
+	checkCtx advisor.Context,
+) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &statementWhereDisallowFunctionsAndCalculationsChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type statementWhereDisallowFunctionsAndCalculationsChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+
+	text                string
+	whereDepth          int
+	reportedFunction    bool
+	reportedCalculation bool
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	c.text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	c.reportedFunction = false
+	c.reportedCalculation = false
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) ExitWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth--
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) ExitWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth--
+}
+
+// EnterFunc_expr handles function calls such as lower(name) or coalesce(a, b).
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterFunc_expr(ctx *parser.Func_exprContext) {
+	if c.whereDepth == 0 || c.reportedFunction {
+		return
+	}
+	c.reportedFunction = true
+	c.addAdvice("Function", ctx.GetStart().GetLine())
+}
+
+// EnterA_expr_add handles the + and - operators.
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterA_expr_add(ctx *parser.A_expr_addContext) {
+	if len(ctx.AllA_expr_mul()) > 1 {
+		c.checkCalculation(ctx.GetStart().GetLine())
+	}
+}
+
+// EnterA_expr_mul handles the *, / and % operators.
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) EnterA_expr_mul(ctx *parser.A_expr_mulContext) {
+	if len(ctx.AllA_expr_caret()) > 1 {
+		c.checkCalculation(ctx.GetStart().GetLine())
+	}
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) checkCalculation(line int) {
+	if c.whereDepth == 0 || c.reportedCalculation {
+		return
+	}
+	c.reportedCalculation = true
+	c.addAdvice("Calculation", line)
+}
+
+func (c *statementWhereDisallowFunctionsAndCalculationsChecker) addAdvice(kind string, line int) {
+	c.adviceList = append(c.adviceList, &types.Advice{
+		Status:  c.level,
+		Code:    int32(types.DisabledFunction),
+		Title:   c.title,
+		Content: fmt.Sprintf("%s is disallowed in where clause, but \"%s\" uses", kind, c.text),
+		StartPosition: &types.Position{
+			Line: int32(line),
+		},
+	})
+}
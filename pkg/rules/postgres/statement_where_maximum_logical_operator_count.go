@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*StatementWhereMaximumLogicalOperatorCountAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementWhereMaximumLogicalOperatorCount),
+		&StatementWhereMaximumLogicalOperatorCountAdvisor{},
+	)
+}
+
+// StatementWhereMaximumLogicalOperatorCountAdvisor is the advisor checking the number of
+// OR operands and IN list items in WHERE clauses.
+type StatementWhereMaximumLogicalOperatorCountAdvisor struct{}
+
+func (*StatementWhereMaximumLogicalOperatorCountAdvisor) Check(
+	_ context.Context,
+	checkCtx advisor.Context,
+) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(checkCtx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Number <= 0 {
+		return nil, nil
+	}
+
+	checker := &statementWhereMaximumLogicalOperatorCountChecker{
+		level:   level,
+		title:   string(checkCtx.Rule.Type),
+		maximum: payload.Number,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type statementWhereMaximumLogicalOperatorCountChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+	maximum    int
+
+	text           string
+	whereDepth     int
+	reportedIn     bool
+	maxOrCount     int
+	maxOrCountLine int
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) EnterStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	c.text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	c.reportedIn = false
+	c.maxOrCount = 0
+	c.maxOrCountLine = 0
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) ExitStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	if c.maxOrCount > c.maximum {
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status: c.level,
+			Code:   int32(types.StatementWhereMaximumLogicalOperatorCount),
+			Title:  c.title,
+			Content: fmt.Sprintf(
+				"Number of tokens (%d) in the OR predicate operation exceeds limit (%d) in statement \"%s\".",
+				c.maxOrCount,
+				c.maximum,
+				c.text,
+			),
+			StartPosition: &types.Position{
+				Line: int32(c.maxOrCountLine),
+			},
+		})
+	}
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) EnterWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) ExitWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth--
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) EnterWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) ExitWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth--
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) EnterA_expr_or(ctx *parser.A_expr_orContext) {
+	if c.whereDepth == 0 {
+		return
+	}
+
+	count := len(ctx.AllA_expr_and())
+	if count > c.maxOrCount {
+		c.maxOrCount = count
+		c.maxOrCountLine = ctx.GetStart().GetLine()
+	}
+}
+
+func (c *statementWhereMaximumLogicalOperatorCountChecker) EnterIn_expr_list(ctx *parser.In_expr_listContext) {
+	if c.whereDepth == 0 || c.reportedIn || ctx.Expr_list() == nil {
+		return
+	}
+
+	count := len(ctx.Expr_list().AllA_expr())
+	if count > c.maximum {
+		c.reportedIn = true
+		c.adviceList = append(c.adviceList, &types.Advice{
+			Status: c.level,
+			Code:   int32(types.StatementWhereMaximumLogicalOperatorCount),
+			Title:  c.title,
+			Content: fmt.Sprintf(
+				"Number of tokens (%d) in IN predicate operation exceeds limit (%d) in statement \"%s\".",
+				count,
+				c.maximum,
+				c.text,
+			),
+			StartPosition: &types.Position{
+				Line: int32(ctx.GetStart().GetLine()),
+			},
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/gedhean/parser/postgresql"
+	"github.com/nsxbet/sql-reviewer/pkg/advisor"
+	"github.com/nsxbet/sql-reviewer/pkg/types"
+)
+
+var _ advisor.Advisor = (*StatementWhereNoEqualNullAdvisor)(nil)
+
+func init() {
+	advisor.Register(
+		types.Engine_POSTGRES,
+		advisor.Type(advisor.SchemaRuleStatementWhereNoEqualNull),
+		&StatementWhereNoEqualNullAdvisor{},
+	)
+}
+
+// StatementWhereNoEqualNullAdvisor is the advisor checking for "= NULL" comparisons in WHERE clauses.
+type StatementWhereNoEqualNullAdvisor struct{}
+
+func (*StatementWhereNoEqualNullAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*types.Advice, error) {
+	tree, err := getANTLRTree(checkCtx)
+	if err != nil {
+		return ConvertSyntaxErrorToAdvice(err)
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &statementWhereNoEqualNullChecker{
+		level: level,
+		title: string(checkCtx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree.Tree)
+
+	return checker.adviceList, nil
+}
+
+type statementWhereNoEqualNullChecker struct {
+	*parser.BasePostgreSQLParserListener
+
+	adviceList []*types.Advice
+	level      types.Advice_Status
+	title      string
+
+	text       string
+	whereDepth int
+	reported   bool
+}
+
+func (c *statementWhereNoEqualNullChecker) EnterStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	c.text = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
+	c.reported = false
+}
+
+func (c *statementWhereNoEqualNullChecker) EnterWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereNoEqualNullChecker) ExitWhere_clause(*parser.Where_clauseContext) {
+	c.whereDepth--
+}
+
+func (c *statementWhereNoEqualNullChecker) EnterWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth++
+}
+
+func (c *statementWhereNoEqualNullChecker) ExitWhere_or_current_clause(*parser.Where_or_current_clauseContext) {
+	c.whereDepth--
+}
+
+func (c *statementWhereNoEqualNullChecker) EnterA_expr_compare(ctx *parser.A_expr_compareContext) {
+	if c.whereDepth == 0 || c.reported {
+		return
+	}
+	if ctx.EQUAL() == nil && ctx.NOT_EQUALS() == nil {
+		return
+	}
+
+	for _, operand := range ctx.AllA_expr_like() {
+		if strings.EqualFold(operand.GetText(), "NULL") {
+			c.reported = true
+			c.adviceList = append(c.adviceList, &types.Advice{
+				Status:  c.level,
+				Code:    int32(types.StatementWhereNoEqualNull),
+				Title:   c.title,
+				Content: fmt.Sprintf("WHERE clause contains equal null: %s", c.text),
+				StartPosition: &types.Position{
+					Line: int32(ctx.GetStart().GetLine()),
+				},
+			})
+			return
+		}
+	}
+}
@@ -72,6 +72,132 @@ func extractSchemaName(ctx parser.IQualified_nameContext) string {
 	return parts[0]
 }
 
+// quoteQualifiedName returns the quoted form of a qualified name as it is written
+// in the statement, e.g. "tech_book" or "public"."tech_book".
+func quoteQualifiedName(ctx parser.IQualified_nameContext) string {
+	if ctx == nil {
+		return ""
+	}
+
+	parts := pgparser.NormalizePostgreSQLQualifiedName(ctx)
+	quoted := make([]string, 0, len(parts))
+	for _, part := range parts {
+		quoted = append(quoted, fmt.Sprintf("%q", part))
+	}
+	return strings.Join(quoted, ".")
+}
+
+// droppedColumnName returns the column name if the command is
+// DROP [COLUMN] [IF EXISTS] column_name.
+func droppedColumnName(cmd parser.IAlter_table_cmdContext) (string, bool) {
+	if cmd == nil || cmd.DROP() == nil || cmd.ALTER() != nil || cmd.CONSTRAINT() != nil || len(cmd.AllColid()) == 0 {
+		return "", false
+	}
+	return pgparser.NormalizePostgreSQLColid(cmd.Colid(0)), true
+}
+
+// getTypeText returns the type as written in the statement, keeping the whitespace
+// between words, e.g. "character varying(20)".
+func getTypeText(typename parser.ITypenameContext) string {
+	if typename == nil {
+		return ""
+	}
+	return typename.GetParser().GetTokenStream().GetTextFromRuleContext(typename)
+}
+
+// tableReference is a table referenced in a FROM clause.
+type tableReference struct {
+	schema string
+	table  string
+	alias  string
+}
+
+// extractTableReferences returns the tables referenced by a FROM clause, including
+// joined tables. Subqueries and table functions are skipped.
+func extractTableReferences(ctx parser.IFrom_clauseContext) []*tableReference {
+	if ctx == nil || ctx.From_list() == nil {
+		return nil
+	}
+
+	var refs []*tableReference
+	for _, tableRef := range ctx.From_list().AllTable_ref() {
+		refs = append(refs, extractTableRef(tableRef)...)
+	}
+	return refs
+}
+
+func extractTableRef(ctx parser.ITable_refContext) []*tableReference {
+	if ctx == nil {
+		return nil
+	}
+
+	var refs []*tableReference
+	if ctx.Relation_expr() != nil && ctx.Relation_expr().Qualified_name() != nil {
+		qualifiedName := ctx.Relation_expr().Qualified_name()
+		ref := &tableReference{
+			schema: NormalizeSchemaName(extractSchemaName(qualifiedName)),
+			table:  extractTableName(qualifiedName),
+		}
+		if alias := ctx.Opt_alias_clause(); alias != nil && alias.Table_alias_clause() != nil &&
+			alias.Table_alias_clause().Table_alias() != nil {
+			ref.alias = normalizeIdentifier(alias.Table_alias_clause().Table_alias().GetText())
+		}
+		refs = append(refs, ref)
+	}
+	if ctx.Table_ref() != nil {
+		refs = append(refs, extractTableRef(ctx.Table_ref())...)
+	}
+	for _, joined := range ctx.AllJoined_table() {
+		refs = append(refs, extractTableRef(joined.Table_ref())...)
+	}
+	return refs
+}
+
+// findTableReference returns the table a column qualifier refers to. An empty
+// qualifier resolves only when exactly one table is referenced.
+func findTableReference(refs []*tableReference, qualifier string) *tableReference {
+	if qualifier == "" {
+		if len(refs) == 1 {
+			return refs[0]
+		}
+		return nil
+	}
+	for _, ref := range refs {
+		if ref.alias == qualifier || (ref.alias == "" && ref.table == qualifier) {
+			return ref
+		}
+	}
+	return nil
+}
+
+// asColumnRef returns the column reference if the expression is nothing but a
+// column reference, e.g. "t.id" but not "t.id + 1" or "lower(t.name)".
+func asColumnRef(ctx antlr.Tree) parser.IColumnrefContext {
+	for ctx != nil {
+		if columnRef, ok := ctx.(parser.IColumnrefContext); ok {
+			return columnRef
+		}
+		if ctx.GetChildCount() != 1 {
+			return nil
+		}
+		ctx = ctx.GetChild(0)
+	}
+	return nil
+}
+
+// splitColumnRef splits a normalized column reference into its qualifier and column name.
+func splitColumnRef(columnRef parser.IColumnrefContext) (string, string) {
+	parts := pgparser.NormalizePostgreSQLColumnRef(columnRef)
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return "", parts[0]
+	default:
+		return parts[len(parts)-2], parts[len(parts)-1]
+	}
+}
+
 // extractIntegerConstant extracts an integer value from an iconst context.
 //
 //nolint:unused